@@ -1,12 +1,23 @@
 package projects
 
 import (
+	"bufio"
 	"fmt"
+	"os"
+	"os/signal"
 	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
 
 	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/jsonpath"
+	"k8s.io/apimachinery/pkg/watch"
 	restclient "k8s.io/client-go/rest"
 	kclientcmd "k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -25,6 +36,11 @@ import (
 	projectclient "github.com/openshift/origin/pkg/project/generated/internalclientset/typed/project/internalversion"
 )
 
+// CurrentProjectAnnotation marks the ProjectList entry that matches the
+// current context's namespace so that scripted consumers of `-o json`
+// and friends can detect the active project without re-parsing kubeconfig.
+const CurrentProjectAnnotation = "projects.openshift.io/current"
+
 type ProjectsOptions struct {
 	Config       clientcmdapi.Config
 	ClientConfig *restclient.Config
@@ -36,6 +52,17 @@ type ProjectsOptions struct {
 	CommandName string
 
 	DisplayShort bool
+	Interactive  bool
+
+	LabelSelector string
+	FieldSelector string
+	Requester     string
+	SortBy        string
+	ChunkSize     int64
+	Watch         bool
+	WatchOnly     bool
+
+	PrintFlags *genericclioptions.PrintFlags
 
 	genericclioptions.IOStreams
 }
@@ -44,6 +71,8 @@ func NewProjectsOptions(name string, streams genericclioptions.IOStreams) *Proje
 	return &ProjectsOptions{
 		IOStreams:   streams,
 		CommandName: name,
+		PrintFlags:  genericclioptions.NewPrintFlags(""),
+		ChunkSize:   500,
 	}
 }
 
@@ -86,6 +115,15 @@ func NewCmdProjects(fullName string, f kcmdutil.Factory, streams genericclioptio
 	}
 
 	cmd.Flags().BoolVarP(&o.DisplayShort, "short", "q", false, "If true, display only the project names")
+	cmd.Flags().StringVarP(&o.LabelSelector, "selector", "l", "", "Selector (label query) to filter projects on, supports '=', '==', and '!='")
+	cmd.Flags().StringVar(&o.FieldSelector, "field-selector", "", "Selector (field query) to filter projects on, supports '=', '==', and '!='")
+	cmd.Flags().StringVar(&o.Requester, "requester", "", "Only list projects requested by this user")
+	cmd.Flags().StringVar(&o.SortBy, "sort-by", "", "If non-empty, sort projects by this jsonpath expression instead of by name")
+	cmd.Flags().BoolVar(&o.Interactive, "interactive", false, "Prompt for a project to switch to when more than one is available. Ignored when stdin or stdout is not a terminal.")
+	cmd.Flags().Int64Var(&o.ChunkSize, "chunk-size", o.ChunkSize, "Return large lists of projects in chunks rather than all at once, improving responsiveness and memory use on clusters with many projects")
+	cmd.Flags().BoolVarP(&o.Watch, "watch", "w", false, "After listing the projects, watch for changes and print ADDED/MODIFIED/DELETED lines as they happen")
+	cmd.Flags().BoolVar(&o.WatchOnly, "watch-only", false, "Watch for changes without listing the projects first; implies --watch")
+	o.PrintFlags.AddFlags(cmd)
 	return cmd
 }
 
@@ -144,77 +182,582 @@ func (o ProjectsOptions) RunProjects() error {
 		}
 	}
 
+	if o.WatchOnly {
+		return o.watchProjects(client)
+	}
+
 	var defaultContextName string
 	if currentContext != nil {
 		defaultContextName = clientcfg.GetContextNickname(currentContext.Namespace, currentContext.Cluster, currentContext.AuthInfo)
 	}
 
-	var msg string
-	projects, err := ocproject.GetProjects(client, o.KubeClient)
-	if err == nil {
-		switch len(projects) {
-		case 0:
-			if !o.DisplayShort {
-				msg += "You are not a member of any projects. You can request a project to be created with the 'new-project' command."
+	// -o/--interactive both need the full, in-memory list of projects to do their job
+	// (there's no meaningful way to stream JSON/YAML output or a picker menu), so they
+	// opt out of the streamed path below and always pull every page up front, the same
+	// way `oc get -o json` fetches chunked pages but still renders one complete object.
+	wantsFullList := (o.PrintFlags.OutputFormat != nil && len(*o.PrintFlags.OutputFormat) > 0) || o.Interactive
+
+	var projects []projectapi.Project
+	var streamed bool
+	if wantsFullList {
+		var err error
+		projects, err = o.listAllProjects(client)
+		if err != nil {
+			return err
+		}
+	} else {
+		firstPage, continueToken, err := o.listFirstPage(client)
+		if err != nil {
+			return err
+		}
+		if len(continueToken) == 0 {
+			// Everything fit in a single page: keep behaving exactly as before so the
+			// common case (a handful of projects) isn't disturbed by pagination.
+			projects = firstPage
+		} else {
+			// More pages remain. Printing now and streaming the rest avoids holding a
+			// cluster's entire project list in memory just to sort and print it once.
+			streamed = true
+			if err := o.streamProjectPages(client, firstPage, continueToken, currentProject, currentProjectExists); err != nil {
+				return err
 			}
-		case 1:
-			if o.DisplayShort {
-				msg += fmt.Sprintf("%s", projects[0].Name)
-			} else {
-				msg += fmt.Sprintf("You have one project on this server: %q.", projectapihelpers.DisplayNameAndNameForProject(&projects[0]))
+		}
+	}
+
+	if streamed {
+		if !currentProjectExists && !o.DisplayShort {
+			if kapierrors.IsForbidden(currentProjectErr) {
+				fmt.Printf("You do not have rights to view project %q. Please switch to an existing one.\n", currentProject)
 			}
-		default:
-			asterisk := ""
-			count := 0
-			if !o.DisplayShort {
-				msg += fmt.Sprintf("You have access to the following projects and can switch between them with '%s project <projectname>':\n", o.CommandName)
+			return currentProjectErr
+		}
+		if !o.DisplayShort {
+			o.printCurrentProjectFooter(config, currentProject, defaultContextName)
+		}
+		if o.Watch {
+			return o.watchProjects(client)
+		}
+		return nil
+	}
+
+	if o.PrintFlags.OutputFormat != nil && len(*o.PrintFlags.OutputFormat) > 0 {
+		if err := o.printProjects(projects, currentProject); err != nil {
+			return err
+		}
+		if o.Watch {
+			return o.watchProjects(client)
+		}
+		return nil
+	}
+
+	if o.Interactive && len(projects) > 1 && isTerminal(os.Stdout) && isTerminal(os.Stdin) {
+		handled, err := o.runInteractivePicker(projects, currentProject)
+		if handled {
+			if err != nil {
+				return err
 			}
+			if o.Watch {
+				return o.watchProjects(client)
+			}
+			return nil
+		}
+		// Reading the selection failed (most commonly EOF from a closed or non-interactive
+		// stdin slipping past the isTerminal check) - fall back to the plain listing below
+		// instead of failing the whole command.
+	}
 
-			sort.Sort(SortByProjectName(projects))
-			for _, project := range projects {
-				count = count + 1
-				displayName := project.Annotations[oapi.OpenShiftDisplayName]
-				linebreak := "\n"
-				if len(displayName) == 0 {
-					displayName = project.Annotations["displayName"]
-				}
+	var msg string
+	switch len(projects) {
+	case 0:
+		if !o.DisplayShort {
+			msg += "You are not a member of any projects. You can request a project to be created with the 'new-project' command."
+		}
+	case 1:
+		if o.DisplayShort {
+			msg += fmt.Sprintf("%s", projects[0].Name)
+		} else {
+			msg += fmt.Sprintf("You have one project on this server: %q.", projectapihelpers.DisplayNameAndNameForProject(&projects[0]))
+		}
+	default:
+		asterisk := ""
+		count := 0
+		if !o.DisplayShort {
+			msg += fmt.Sprintf("You have access to the following projects and can switch between them with '%s project <projectname>':\n", o.CommandName)
+		}
 
-				if currentProjectExists && !o.DisplayShort {
-					asterisk = "    "
-					if currentProject == project.Name {
-						asterisk = "  * "
-					}
+		o.sortProjects(projects)
+		for _, project := range projects {
+			count = count + 1
+			displayName := project.Annotations[oapi.OpenShiftDisplayName]
+			linebreak := "\n"
+			if len(displayName) == 0 {
+				displayName = project.Annotations["displayName"]
+			}
+
+			if currentProjectExists && !o.DisplayShort {
+				asterisk = "    "
+				if currentProject == project.Name {
+					asterisk = "  * "
 				}
-				if len(displayName) > 0 && displayName != project.Name && !o.DisplayShort {
-					msg += fmt.Sprintf("\n"+asterisk+"%s - %s", project.Name, displayName)
-				} else {
-					if o.DisplayShort && count == 1 {
-						linebreak = ""
-					}
-					msg += fmt.Sprintf(linebreak+asterisk+"%s", project.Name)
+			}
+			if len(displayName) > 0 && displayName != project.Name && !o.DisplayShort {
+				msg += fmt.Sprintf("\n"+asterisk+"%s - %s", project.Name, displayName)
+			} else {
+				if o.DisplayShort && count == 1 {
+					linebreak = ""
 				}
+				msg += fmt.Sprintf(linebreak+asterisk+"%s", project.Name)
 			}
 		}
-		fmt.Println(msg)
+	}
+	fmt.Println(msg)
 
-		if len(projects) > 0 && !o.DisplayShort {
-			if !currentProjectExists {
-				if kapierrors.IsForbidden(currentProjectErr) {
-					fmt.Printf("You do not have rights to view project %q. Please switch to an existing one.\n", currentProject)
-				}
-				return currentProjectErr
+	if len(projects) > 0 && !o.DisplayShort {
+		if !currentProjectExists {
+			if kapierrors.IsForbidden(currentProjectErr) {
+				fmt.Printf("You do not have rights to view project %q. Please switch to an existing one.\n", currentProject)
 			}
+			return currentProjectErr
+		}
 
-			// if they specified a project name and got a generated context, then only show the information they care about.  They won't recognize
-			// a context name they didn't choose
-			if config.CurrentContext == defaultContextName {
-				fmt.Fprintf(o.Out, "\nUsing project %q on server %q.\n", currentProject, o.ClientConfig.Host)
-			} else {
-				fmt.Fprintf(o.Out, "\nUsing project %q from context named %q on server %q.\n", currentProject, config.CurrentContext, o.ClientConfig.Host)
+		o.printCurrentProjectFooter(config, currentProject, defaultContextName)
+	}
+
+	if o.Watch {
+		return o.watchProjects(client)
+	}
+	return nil
+}
+
+// printCurrentProjectFooter prints the trailing "Using project ..." line, using the
+// generated context name to decide whether the user will recognize the context by name.
+func (o ProjectsOptions) printCurrentProjectFooter(config clientcmdapi.Config, currentProject, defaultContextName string) {
+	if config.CurrentContext == defaultContextName {
+		fmt.Fprintf(o.Out, "\nUsing project %q on server %q.\n", currentProject, o.ClientConfig.Host)
+	} else {
+		fmt.Fprintf(o.Out, "\nUsing project %q from context named %q on server %q.\n", currentProject, config.CurrentContext, o.ClientConfig.Host)
+	}
+}
+
+// fieldSelector returns the --field-selector value understood by the project API.
+// --requester is deliberately not folded in here: it's read elsewhere in this file as an
+// annotation (project.Annotations[projectapi.ProjectRequester]), not a field the project API
+// registers for field-selector matching, so forging it into a server-side field selector
+// would fail with "field label not supported". filterByRequester applies it client-side
+// instead, after the list/watch call returns.
+func (o ProjectsOptions) fieldSelector() string {
+	return o.FieldSelector
+}
+
+// hasProjectFilter reports whether the user asked to narrow the listing down via --selector
+// or --field-selector, the two filters the self-SAR based fallback listing can't honor.
+// --requester isn't included: it's applied client-side by filterByRequester, which works
+// against that fallback's results just as well as against a paginated List/Watch.
+func (o ProjectsOptions) hasProjectFilter() bool {
+	return len(o.LabelSelector) > 0 || len(o.fieldSelector()) > 0
+}
+
+// filterByRequester returns the subset of projects annotated as requested by --requester,
+// or projects unchanged if --requester wasn't set.
+func (o ProjectsOptions) filterByRequester(projects []projectapi.Project) []projectapi.Project {
+	if len(o.Requester) == 0 {
+		return projects
+	}
+	filtered := make([]projectapi.Project, 0, len(projects))
+	for _, project := range projects {
+		if project.Annotations[projectapi.ProjectRequester] == o.Requester {
+			filtered = append(filtered, project)
+		}
+	}
+	return filtered
+}
+
+// listFirstPage fetches up to --chunk-size projects, filtered client-side by --requester
+// (see filterByRequester). The returned continue token is non-empty when more pages remain.
+// If the user lacks cluster-wide list permission on projects, it falls back to the
+// non-paginated self-subject-access-review based listing used before --chunk-size existed;
+// that fallback has no notion of a continue token, so callers never see streaming in that
+// case. That fallback also has no notion of --selector/--field-selector, so a request using
+// either that hits it fails loudly instead of silently returning an unfiltered list the user
+// would otherwise mistake for a filtered one; --requester still works against it, since it's
+// filtered client-side regardless of which path produced the projects.
+func (o ProjectsOptions) listFirstPage(client projectclient.ProjectInterface) ([]projectapi.Project, string, error) {
+	list, err := client.List(metav1.ListOptions{
+		LabelSelector: o.LabelSelector,
+		FieldSelector: o.fieldSelector(),
+		Limit:         o.ChunkSize,
+	})
+	if err != nil {
+		if kapierrors.IsForbidden(err) {
+			if o.hasProjectFilter() {
+				return nil, "", fmt.Errorf("--selector and --field-selector require permission to list projects cluster-wide, which this user does not have: %v", err)
 			}
+			projects, err := ocproject.GetProjects(client, o.KubeClient)
+			if err != nil {
+				return nil, "", err
+			}
+			return o.filterByRequester(projects), "", nil
 		}
-		return nil
+		return nil, "", err
+	}
+	return o.filterByRequester(list.Items), list.Continue, nil
+}
+
+// listAllProjects walks every page via Limit/Continue and returns the whole result as one
+// slice, for the callers (-o, --interactive) that need the complete list in memory up
+// front rather than a page at a time.
+func (o ProjectsOptions) listAllProjects(client projectclient.ProjectInterface) ([]projectapi.Project, error) {
+	projects, continueToken, err := o.listFirstPage(client)
+	if err != nil {
+		return nil, err
+	}
+
+	for len(continueToken) > 0 {
+		list, err := client.List(metav1.ListOptions{
+			LabelSelector: o.LabelSelector,
+			FieldSelector: o.fieldSelector(),
+			Limit:         o.ChunkSize,
+			Continue:      continueToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, o.filterByRequester(list.Items)...)
+		continueToken = list.Continue
+	}
+
+	return projects, nil
+}
+
+// watchReconnectInitialBackoff and watchReconnectMaxBackoff bound the delay between
+// watchProjects' reconnect attempts, so a server that closes the watch immediately on every
+// try (auth hiccup, LB issue, etc.) gets backed off against instead of hammered in a tight loop.
+const (
+	watchReconnectInitialBackoff = 1 * time.Second
+	watchReconnectMaxBackoff     = 30 * time.Second
+)
+
+// watchProjects opens a watch on the project resource and prints an ADDED/MODIFIED/DELETED
+// line for every event, reconnecting with a fresh relist whenever the watch closes because
+// its resourceVersion expired. Reconnects back off exponentially, the same retry behavior
+// the standard ListWatch gives a reflector. It returns when the user interrupts with SIGINT.
+func (o ProjectsOptions) watchProjects(client projectclient.ProjectInterface) error {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	defer signal.Stop(sigCh)
+
+	w := tabwriter.NewWriter(o.Out, 0, 2, 2, ' ', 0)
+	defer w.Flush()
+
+	backoff := watchReconnectInitialBackoff
+	for {
+		watcher, err := client.Watch(metav1.ListOptions{
+			LabelSelector: o.LabelSelector,
+			FieldSelector: o.fieldSelector(),
+		})
+		if err != nil {
+			return err
+		}
+
+		interrupted := o.watchLoop(watcher, sigCh, w)
+		watcher.Stop()
+		if interrupted {
+			return nil
+		}
+
+		// The channel closed on its own rather than being interrupted: the watch's
+		// resourceVersion most likely expired, so relist from scratch and reconnect. Back
+		// off first in case the close is immediate and repeated, but stay interruptible.
+		select {
+		case <-sigCh:
+			return nil
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > watchReconnectMaxBackoff {
+			backoff = watchReconnectMaxBackoff
+		}
+	}
+}
+
+// watchLoop drains a single watch's event channel, printing each event, until either the
+// channel closes (the watch expired; returns false so the caller reconnects) or SIGINT
+// fires (returns true so the caller stops for good).
+func (o ProjectsOptions) watchLoop(watcher watch.Interface, sigCh <-chan os.Signal, w *tabwriter.Writer) bool {
+	for {
+		select {
+		case <-sigCh:
+			return true
+		case event, ok := <-watcher.ResultChan():
+			if !ok || event.Type == watch.Error {
+				return false
+			}
+			project, ok := event.Object.(*projectapi.Project)
+			if !ok {
+				continue
+			}
+			if len(o.Requester) > 0 && project.Annotations[projectapi.ProjectRequester] != o.Requester {
+				continue
+			}
+			if !o.DisplayShort {
+				fmt.Fprintf(w, "%s\t%s\n", event.Type, project.Name)
+			} else if event.Type == watch.Added {
+				fmt.Fprintf(w, "%s\n", project.Name)
+			}
+			w.Flush()
+		}
+	}
+}
+
+// streamProjectPages prints firstPage and then walks the remaining pages one chunk at a
+// time via Limit/Continue, writing straight to a tabwriter instead of buffering the whole
+// list. When --sort-by is unset each page is sorted by name on its own and printed
+// immediately, which is cheap and keeps memory bounded to one page. When --sort-by is set
+// the global order can't be known until every page has been seen, so pages are accumulated
+// instead of printed as they arrive, and the full set is sorted and printed once the last
+// page comes in. That costs the memory savings pagination otherwise buys, but it's the
+// price of a correct global sort — silently dropping projects to keep memory bounded is
+// worse than the tradeoff it's meant to avoid.
+func (o ProjectsOptions) streamProjectPages(client projectclient.ProjectInterface, firstPage []projectapi.Project, continueToken, currentProject string, currentProjectExists bool) error {
+	w := tabwriter.NewWriter(o.Out, 0, 2, 2, ' ', 0)
+	defer w.Flush()
+
+	if !o.DisplayShort {
+		fmt.Fprintf(w, "You have access to the following projects and can switch between them with '%s project <projectname>':\n", o.CommandName)
+	}
+
+	sorting := len(o.SortBy) > 0
+	var accumulated []projectapi.Project
+
+	page := firstPage
+	for {
+		if sorting {
+			accumulated = append(accumulated, page...)
+		} else {
+			o.printProjectPage(w, page, currentProject, currentProjectExists)
+		}
+
+		if len(continueToken) == 0 {
+			break
+		}
+
+		list, err := client.List(metav1.ListOptions{
+			LabelSelector: o.LabelSelector,
+			FieldSelector: o.fieldSelector(),
+			Limit:         o.ChunkSize,
+			Continue:      continueToken,
+		})
+		if err != nil {
+			return err
+		}
+		page = o.filterByRequester(list.Items)
+		continueToken = list.Continue
+	}
+
+	if sorting {
+		o.sortProjects(accumulated)
+		o.printProjectPage(w, accumulated, currentProject, currentProjectExists)
+	}
+
+	return nil
+}
+
+// printProjectPage writes one page of projects in the same "name - displayName" / short
+// format used by the non-streaming listing.
+func (o ProjectsOptions) printProjectPage(w *tabwriter.Writer, projects []projectapi.Project, currentProject string, currentProjectExists bool) {
+	if len(o.SortBy) == 0 {
+		o.sortProjects(projects)
+	}
+
+	for _, project := range projects {
+		if o.DisplayShort {
+			fmt.Fprintf(w, "%s\n", project.Name)
+			continue
+		}
+
+		asterisk := "    "
+		if currentProjectExists && currentProject == project.Name {
+			asterisk = "  * "
+		}
+		displayName := project.Annotations[oapi.OpenShiftDisplayName]
+		if len(displayName) == 0 {
+			displayName = project.Annotations["displayName"]
+		}
+		if len(displayName) > 0 && displayName != project.Name {
+			fmt.Fprintf(w, "%s%s - %s\n", asterisk, project.Name, displayName)
+		} else {
+			fmt.Fprintf(w, "%s%s\n", asterisk, project.Name)
+		}
+	}
+}
+
+// sortProjects orders projects by the --sort-by jsonpath expression when one was given,
+// falling back to the default sort by name.
+func (o ProjectsOptions) sortProjects(projects []projectapi.Project) {
+	if len(o.SortBy) == 0 {
+		sort.Sort(SortByProjectName(projects))
+		return
+	}
+
+	parser := jsonpath.New("sort-by").AllowMissingKeys(true)
+	if err := parser.Parse(relaxedJSONPathExpression(o.SortBy)); err != nil {
+		sort.Sort(SortByProjectName(projects))
+		return
+	}
+
+	keys := make([]string, len(projects))
+	for i := range projects {
+		values, err := parser.FindResults(projects[i])
+		if err != nil || len(values) == 0 || len(values[0]) == 0 {
+			continue
+		}
+		keys[i] = fmt.Sprintf("%v", values[0][0].Interface())
+	}
+
+	sort.SliceStable(projects, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+}
+
+// relaxedJSONPathExpression wraps a bare field path in the {} syntax jsonpath.Parse expects,
+// the same convenience kubectl's --sort-by offers.
+func relaxedJSONPathExpression(path string) string {
+	if len(path) == 0 {
+		return path
+	}
+	if path[0] == '{' && path[len(path)-1] == '}' {
+		return path
+	}
+	return fmt.Sprintf("{%s}", path)
+}
+
+// isTerminal returns true if w is connected to a terminal, the same check the login flow
+// uses to decide whether it is safe to prompt. RunProjects checks both os.Stdout, where the
+// menu is shown, and os.Stdin, which determines whether reading a selection will actually work.
+func isTerminal(w interface{}) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	return terminal.IsTerminal(int(f.Fd()))
+}
+
+// runInteractivePicker renders a numbered menu of projects, reads a selection from stdin,
+// and switches the current context to it the same way `oc project <name>` would. This
+// combines `oc projects` and `oc project <name>` into a single step for interactive use.
+// The bool return reports whether the picker was able to read a selection at all: it is
+// false (with a nil error) when stdin hit EOF or another read error before a choice was
+// made, so the caller can fall back to today's plain, non-interactive listing the same
+// way it would for a non-TTY invocation, rather than failing the command outright.
+func (o ProjectsOptions) runInteractivePicker(projects []projectapi.Project, currentProject string) (bool, error) {
+	o.sortProjects(projects)
+
+	fmt.Fprintln(o.Out, "Select a project:")
+	for i, project := range projects {
+		displayName := project.Annotations[oapi.OpenShiftDisplayName]
+		requester := project.Annotations[projectapi.ProjectRequester]
+		label := project.Name
+		switch {
+		case len(displayName) > 0 && len(requester) > 0:
+			label = fmt.Sprintf("%s (%s, requested by %s)", project.Name, displayName, requester)
+		case len(displayName) > 0:
+			label = fmt.Sprintf("%s (%s)", project.Name, displayName)
+		case len(requester) > 0:
+			label = fmt.Sprintf("%s (requested by %s)", project.Name, requester)
+		}
+		fmt.Fprintf(o.Out, "%3d. %s\n", i+1, label)
+	}
+
+	fmt.Fprintf(o.Out, "Select project (1-%d): ", len(projects))
+	reader := bufio.NewReader(o.In)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return false, nil
+	}
+
+	choice, err := strconv.Atoi(strings.TrimSpace(line))
+	if err != nil || choice < 1 || choice > len(projects) {
+		return true, fmt.Errorf("invalid selection %q: must be a number between 1 and %d", strings.TrimSpace(line), len(projects))
+	}
+
+	project := projects[choice-1]
+	if project.Name == currentProject {
+		fmt.Fprintf(o.Out, "Already on project %q.\n", project.Name)
+		return true, nil
+	}
+
+	return true, o.switchToProject(project.Name)
+}
+
+// switchToProject sets the current context's namespace to project and persists the
+// change, mirroring what `oc project <name>` does to the kubeconfig.
+func (o ProjectsOptions) switchToProject(project string) error {
+	config := o.Config
+	currentContext := config.Contexts[config.CurrentContext]
+	if currentContext == nil {
+		return fmt.Errorf("no current context is set")
+	}
+
+	currentContext.Namespace = project
+	if err := kclientcmd.ModifyConfig(o.PathOptions, config, true); err != nil {
+		return err
 	}
 
-	return err
+	fmt.Fprintf(o.Out, "Now using project %q on server %q.\n", project, o.ClientConfig.Host)
+	return nil
+}
+
+// projectAPIVersion is the external group/version `-o json|yaml` reports for projects,
+// since the internal projectapi types this command otherwise works with don't carry one.
+const projectAPIVersion = "project.openshift.io/v1"
+
+// printProjects renders projects as a typed project.openshift.io/v1 ProjectList through the
+// requested printer (json, yaml, name, jsonpath, go-template, ...), annotating the entry that
+// matches the current context so scripted consumers can detect the active project.
+func (o ProjectsOptions) printProjects(projects []projectapi.Project, currentProject string) error {
+	o.sortProjects(projects)
+
+	list := &projectapi.ProjectList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ProjectList",
+			APIVersion: projectAPIVersion,
+		},
+		Items: projects,
+	}
+	for i := range list.Items {
+		list.Items[i].TypeMeta = metav1.TypeMeta{
+			Kind:       "Project",
+			APIVersion: projectAPIVersion,
+		}
+		if list.Items[i].Name == currentProject {
+			if list.Items[i].Annotations == nil {
+				list.Items[i].Annotations = map[string]string{}
+			}
+			list.Items[i].Annotations[CurrentProjectAnnotation] = "true"
+		}
+	}
+
+	if o.PrintFlags.OutputFormat != nil && *o.PrintFlags.OutputFormat == "wide" {
+		return o.printProjectsWide(list)
+	}
+
+	printer, err := o.PrintFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+	return printer.PrintObj(list, o.Out)
+}
+
+// printProjectsWide prints a tabular view that includes the display name, requester and phase
+// of each project, mirroring the `-o wide` convention used by other `oc get`-style commands.
+func (o ProjectsOptions) printProjectsWide(list *projectapi.ProjectList) error {
+	w := tabwriter.NewWriter(o.Out, 0, 2, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "NAME\tDISPLAY NAME\tREQUESTER\tSTATUS")
+	for _, project := range list.Items {
+		displayName := project.Annotations[oapi.OpenShiftDisplayName]
+		requester := project.Annotations[projectapi.ProjectRequester]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", project.Name, displayName, requester, project.Status.Phase)
+	}
+	return nil
 }
@@ -0,0 +1,245 @@
+package projects
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/kubectl/genericclioptions"
+
+	oapi "github.com/openshift/origin/pkg/api"
+	projectapi "github.com/openshift/origin/pkg/project/apis/project"
+)
+
+// newTestProjectsOptions builds a ProjectsOptions whose IOStreams read input from in and
+// capture output in a buffer, for exercising runInteractivePicker without a real terminal.
+func newTestProjectsOptions(in string) (ProjectsOptions, *bytes.Buffer) {
+	out := &bytes.Buffer{}
+	o := ProjectsOptions{
+		IOStreams: genericclioptions.IOStreams{
+			In:     strings.NewReader(in),
+			Out:    out,
+			ErrOut: out,
+		},
+	}
+	return o, out
+}
+
+func newTestProject(name, displayName, requester string) projectapi.Project {
+	annotations := map[string]string{}
+	if len(displayName) > 0 {
+		annotations[oapi.OpenShiftDisplayName] = displayName
+	}
+	if len(requester) > 0 {
+		annotations[projectapi.ProjectRequester] = requester
+	}
+	return projectapi.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: annotations,
+		},
+	}
+}
+
+func TestSortProjectsDefaultsToName(t *testing.T) {
+	o := ProjectsOptions{}
+	projects := []projectapi.Project{
+		newTestProject("c", "", ""),
+		newTestProject("a", "", ""),
+		newTestProject("b", "", ""),
+	}
+
+	o.sortProjects(projects)
+
+	got := []string{projects[0].Name, projects[1].Name, projects[2].Name}
+	want := []string{"a", "b", "c"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("sortProjects() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSortProjectsBySortByExpression(t *testing.T) {
+	o := ProjectsOptions{SortBy: ".metadata.annotations.displayName"}
+	projects := []projectapi.Project{
+		newTestProject("p1", "", ""),
+		newTestProject("p2", "", ""),
+		newTestProject("p3", "", ""),
+	}
+	// The expression reads the legacy "displayName" annotation, which newTestProject does not set.
+	projects[0].Annotations["displayName"] = "charlie"
+	projects[1].Annotations["displayName"] = "alpha"
+	projects[2].Annotations["displayName"] = "bravo"
+
+	o.sortProjects(projects)
+
+	got := []string{projects[0].Name, projects[1].Name, projects[2].Name}
+	want := []string{"p2", "p3", "p1"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("sortProjects() = %v, want %v", got, want)
+		}
+	}
+}
+
+// TestSortProjectsPreservesAllEntries guards against the pagination+sort-by regression
+// where a bounded top-K heap silently dropped every project beyond --chunk-size once a
+// --sort-by expression was set. Accumulating pages and sorting once, as streamProjectPages
+// now does, must never lose entries.
+func TestSortProjectsPreservesAllEntries(t *testing.T) {
+	o := ProjectsOptions{SortBy: ".metadata.name"}
+
+	page1 := []projectapi.Project{newTestProject("p1", "", ""), newTestProject("p2", "", "")}
+	page2 := []projectapi.Project{newTestProject("p3", "", ""), newTestProject("p4", "", ""), newTestProject("p5", "", "")}
+
+	var accumulated []projectapi.Project
+	accumulated = append(accumulated, page1...)
+	accumulated = append(accumulated, page2...)
+
+	if len(accumulated) != 5 {
+		t.Fatalf("accumulated %d projects, want 5", len(accumulated))
+	}
+
+	o.sortProjects(accumulated)
+
+	want := []string{"p1", "p2", "p3", "p4", "p5"}
+	for i, name := range want {
+		if accumulated[i].Name != name {
+			t.Fatalf("sortProjects() = %v, want %v", accumulated, want)
+		}
+	}
+}
+
+// TestFieldSelector guards against --requester being folded into the server-side field
+// selector: the project API doesn't register "requester" as a selectable field, so
+// fieldSelector() must return only --field-selector and leave --requester to be applied
+// client-side by filterByRequester.
+func TestFieldSelector(t *testing.T) {
+	tests := []struct {
+		name      string
+		field     string
+		requester string
+		want      string
+	}{
+		{name: "empty"},
+		{name: "field only", field: "status.phase=Active", want: "status.phase=Active"},
+		{name: "requester only", requester: "alice", want: ""},
+		{name: "field and requester", field: "status.phase=Active", requester: "alice", want: "status.phase=Active"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := ProjectsOptions{FieldSelector: tt.field, Requester: tt.requester}
+			if got := o.fieldSelector(); got != tt.want {
+				t.Fatalf("fieldSelector() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasProjectFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		o    ProjectsOptions
+		want bool
+	}{
+		{name: "no filters", o: ProjectsOptions{}, want: false},
+		{name: "label selector", o: ProjectsOptions{LabelSelector: "env=prod"}, want: true},
+		{name: "field selector", o: ProjectsOptions{FieldSelector: "status.phase=Active"}, want: true},
+		{name: "requester alone is not a fallback filter", o: ProjectsOptions{Requester: "alice"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.hasProjectFilter(); got != tt.want {
+				t.Fatalf("hasProjectFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterByRequester(t *testing.T) {
+	projects := []projectapi.Project{
+		newTestProject("p1", "", "alice"),
+		newTestProject("p2", "", "bob"),
+		newTestProject("p3", "", "alice"),
+	}
+
+	t.Run("no requester returns all projects unchanged", func(t *testing.T) {
+		o := ProjectsOptions{}
+		got := o.filterByRequester(projects)
+		if len(got) != len(projects) {
+			t.Fatalf("filterByRequester() returned %d projects, want %d", len(got), len(projects))
+		}
+	})
+
+	t.Run("requester filters to matching projects", func(t *testing.T) {
+		o := ProjectsOptions{Requester: "alice"}
+		got := o.filterByRequester(projects)
+		want := []string{"p1", "p3"}
+		if len(got) != len(want) {
+			t.Fatalf("filterByRequester() = %v, want %v", got, want)
+		}
+		for i, name := range want {
+			if got[i].Name != name {
+				t.Fatalf("filterByRequester() = %v, want %v", got, want)
+			}
+		}
+	})
+}
+
+func TestRunInteractivePicker(t *testing.T) {
+	projects := []projectapi.Project{
+		newTestProject("p1", "", ""),
+		newTestProject("p2", "", ""),
+	}
+
+	t.Run("EOF falls back instead of failing", func(t *testing.T) {
+		o, _ := newTestProjectsOptions("")
+		handled, err := o.runInteractivePicker(projects, "p1")
+		if handled {
+			t.Fatalf("runInteractivePicker() handled = true, want false on EOF")
+		}
+		if err != nil {
+			t.Fatalf("runInteractivePicker() err = %v, want nil on EOF", err)
+		}
+	})
+
+	t.Run("non-numeric input is a handled error", func(t *testing.T) {
+		o, _ := newTestProjectsOptions("not-a-number\n")
+		handled, err := o.runInteractivePicker(projects, "p1")
+		if !handled {
+			t.Fatalf("runInteractivePicker() handled = false, want true")
+		}
+		if err == nil {
+			t.Fatalf("runInteractivePicker() err = nil, want an invalid selection error")
+		}
+	})
+
+	t.Run("out-of-range choice is a handled error", func(t *testing.T) {
+		o, _ := newTestProjectsOptions("5\n")
+		handled, err := o.runInteractivePicker(projects, "p1")
+		if !handled {
+			t.Fatalf("runInteractivePicker() handled = false, want true")
+		}
+		if err == nil {
+			t.Fatalf("runInteractivePicker() err = nil, want an invalid selection error")
+		}
+	})
+
+	t.Run("choosing the current project is a no-op", func(t *testing.T) {
+		o, out := newTestProjectsOptions("1\n")
+		handled, err := o.runInteractivePicker(projects, "p1")
+		if !handled {
+			t.Fatalf("runInteractivePicker() handled = false, want true")
+		}
+		if err != nil {
+			t.Fatalf("runInteractivePicker() err = %v, want nil", err)
+		}
+		if !strings.Contains(out.String(), `Already on project "p1"`) {
+			t.Fatalf("runInteractivePicker() output = %q, want it to mention already being on p1", out.String())
+		}
+	})
+}